@@ -0,0 +1,10 @@
+package encoding
+
+// Additional proof tier IDs for ZK proof backends, on top of the protocol's built-in
+// Optimistic / SGX / Guardian tiers.
+const (
+	// TierZkRisc0ID is the tier ID for proofs generated by a RISC Zero zkVM backend.
+	TierZkRisc0ID uint16 = 250
+	// TierZkSp1ID is the tier ID for proofs generated by an SP1 zkVM backend.
+	TierZkSp1ID uint16 = 251
+)
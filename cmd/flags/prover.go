@@ -0,0 +1,5 @@
+package flags
+
+// proverCategory groups every prover-specific flag under the same heading in `--help` output,
+// shared by prover_blob.go, prover_queue.go, and prover_zk.go.
+const proverCategory = "PROVER"
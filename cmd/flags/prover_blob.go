@@ -0,0 +1,25 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// Blob transaction related flags.
+var (
+	L1BlobAllowed = &cli.BoolFlag{
+		Name:     "l1.blobAllowed",
+		Usage:    "Submit block proposal data as an EIP-4844 blob sidecar instead of calldata when the payload is large enough",
+		Category: proverCategory,
+		EnvVars:  []string{"L1_BLOB_ALLOWED"},
+	}
+	L1BlockBuilderTip = &cli.StringFlag{
+		Name:     "l1.blockBuilderTip",
+		Usage:    "Additional tip (in wei) added on top of the suggested priority fee, to help proposals land promptly on MEV-aware builders",
+		Category: proverCategory,
+		EnvVars:  []string{"L1_BLOCK_BUILDER_TIP"},
+	}
+)
+
+// ProverBlobFlags are the flags that enable blob-carrying proposer transactions.
+var ProverBlobFlags = []cli.Flag{
+	L1BlobAllowed,
+	L1BlockBuilderTip,
+}
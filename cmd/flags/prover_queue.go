@@ -0,0 +1,29 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// ProverTierConcurrency configures how many workers each tier's proof queue runs, as a
+// comma-separated list of `tierID=concurrency` pairs, e.g. "100=2,200=1". Tiers not listed fall
+// back to the prover's defaultTierConcurrency (prover/config.go).
+var ProverTierConcurrency = &cli.StringFlag{
+	Name:     "prover.tier.concurrency",
+	Usage:    "Comma-separated `tierID=concurrency` pairs, controlling each tier's proof queue worker pool size",
+	Category: proverCategory,
+	EnvVars:  []string{"PROVER_TIER_CONCURRENCY"},
+}
+
+// ProverProofQueueDir is the directory used to persist each tier's proof request queue, so
+// in-flight assignments survive a prover restart.
+var ProverProofQueueDir = &cli.StringFlag{
+	Name:     "prover.proofQueueDir",
+	Usage:    "Directory used to persist proof request queues across restarts",
+	Category: proverCategory,
+	EnvVars:  []string{"PROVER_PROOF_QUEUE_DIR"},
+	Value:    "./data/prover/proof-queue",
+}
+
+// ProverQueueFlags are the flags that configure the bounded, per-tier proof request queues.
+var ProverQueueFlags = []cli.Flag{
+	ProverTierConcurrency,
+	ProverProofQueueDir,
+}
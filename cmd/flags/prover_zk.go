@@ -0,0 +1,26 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// ZK proof backend endpoint flags, consumed by the RISC Zero and SP1 ProofProducer factories
+// registered in prover/registry.go.
+var (
+	ProverRisc0Endpoint = &cli.StringFlag{
+		Name:     "prover.risc0Endpoint",
+		Usage:    "RISC Zero prover endpoint, used to generate proofs for the TierZkRisc0 tier",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_RISC0_ENDPOINT"},
+	}
+	ProverSp1Endpoint = &cli.StringFlag{
+		Name:     "prover.sp1Endpoint",
+		Usage:    "SP1 prover endpoint, used to generate proofs for the TierZkSp1 tier",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_SP1_ENDPOINT"},
+	}
+)
+
+// ProverZkFlags are the flags that configure the ZK proof backend endpoints.
+var ProverZkFlags = []cli.Flag{
+	ProverRisc0Endpoint,
+	ProverSp1Endpoint,
+}
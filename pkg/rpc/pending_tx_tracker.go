@@ -0,0 +1,234 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// proveBlockABI and approveABI are minimal single-method ABIs, used only to decode the prover's
+// own pending `proveBlock` / `approve` calldata, without depending on the full contract bindings.
+// proveBlockABI mirrors the real `proveBlock(uint64,bytes)` signature built by
+// transaction.ProveBlockTxBuilder — the tier is encoded inside the opaque `input` blob, not as
+// its own argument.
+var (
+	proveBlockABI = mustParseABI(
+		`[{"name":"proveBlock","type":"function","inputs":[` +
+			`{"name":"blockId","type":"uint64"},{"name":"input","type":"bytes"}]}]`,
+	)
+	approveABI = mustParseABI(
+		`[{"name":"approve","type":"function","inputs":[` +
+			`{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}]}]`,
+	)
+)
+
+// mustParseABI parses the given single-method ABI JSON, panicking on failure, since the ABIs
+// above are fixed at compile time.
+func mustParseABI(raw string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+var (
+	pendingTxGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "taiko_prover_pending_txs",
+		Help: "Number of pending proveBlock / approve transactions currently tracked",
+	})
+	stuckTxGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "taiko_prover_stuck_txs",
+		Help: "Number of tracked transactions considered stuck (pending past the stuck threshold)",
+	})
+)
+
+// stuckConfirmations is the number of `Refresh` calls a transaction can remain pending for,
+// before PendingTxTracker considers it stuck and eligible for a gas bump.
+const stuckConfirmations = 3
+
+// trackedTx wraps a pending transaction together with how many refreshes it has survived,
+// so PendingTxTracker can tell a newly-seen pending transaction from a stuck one.
+type trackedTx struct {
+	tx     *types.Transaction
+	misses int
+}
+
+// PendingTxTracker queries the prover address' pending transactions through
+// `txpool_contentFrom`, and indexes the ones this prover cares about — `proveBlock` calls by
+// block ID, and `approve` calls by spender — so callers can avoid submitting a redundant
+// transaction for work that is already in flight, and detect transactions stuck in the mempool.
+type PendingTxTracker struct {
+	rpc           *Client
+	proverAddress common.Address
+
+	mu        sync.RWMutex
+	byBlockID map[uint64]*trackedTx
+	bySpender map[common.Address]*trackedTx
+}
+
+// NewPendingTxTracker creates a new PendingTxTracker instance.
+func NewPendingTxTracker(rpc *Client, proverAddress common.Address) *PendingTxTracker {
+	return &PendingTxTracker{
+		rpc:           rpc,
+		proverAddress: proverAddress,
+		byBlockID:     make(map[uint64]*trackedTx),
+		bySpender:     make(map[common.Address]*trackedTx),
+	}
+}
+
+// Refresh re-queries the prover address' pending transactions, and re-indexes the ones this
+// tracker recognizes as `proveBlock` or `approve` calls.
+func (t *PendingTxTracker) Refresh(ctx context.Context) error {
+	content, err := t.rpc.ContentFrom(ctx, t.proverAddress)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	seenBlockID := make(map[uint64]bool)
+	seenSpender := make(map[common.Address]bool)
+
+	for _, tx := range content.Pending {
+		if blockID, ok := decodeProveBlock(tx); ok {
+			seenBlockID[blockID] = true
+			trackTx(t.byBlockID, blockID, tx)
+			continue
+		}
+
+		if spender, ok := decodeApprove(tx); ok {
+			seenSpender[spender] = true
+			trackTx(t.bySpender, spender, tx)
+		}
+	}
+
+	for blockID := range t.byBlockID {
+		if !seenBlockID[blockID] {
+			delete(t.byBlockID, blockID)
+		}
+	}
+	for spender := range t.bySpender {
+		if !seenSpender[spender] {
+			delete(t.bySpender, spender)
+		}
+	}
+
+	pendingTxGauge.Set(float64(len(t.byBlockID) + len(t.bySpender)))
+	stuckTxGauge.Set(float64(t.countStuckLocked()))
+
+	return nil
+}
+
+// trackTx inserts or updates the tracked transaction for the given key, generic over the two
+// index maps this tracker maintains.
+func trackTx[K comparable](m map[K]*trackedTx, key K, tx *types.Transaction) {
+	if existing, ok := m[key]; ok && existing.tx.Hash() == tx.Hash() {
+		existing.misses++
+		return
+	}
+
+	m[key] = &trackedTx{tx: tx}
+}
+
+// IsProvePending reports whether a `proveBlock` transaction for the given block is currently
+// pending in the mempool.
+func (t *PendingTxTracker) IsProvePending(blockID *big.Int) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	_, ok := t.byBlockID[blockID.Uint64()]
+	return ok
+}
+
+// IsApprovalPending reports whether an `approve` transaction for the given spender is currently
+// pending in the mempool.
+func (t *PendingTxTracker) IsApprovalPending(spender common.Address) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	_, ok := t.bySpender[spender]
+	return ok
+}
+
+// StuckProveTx returns the pending `proveBlock` transaction for the given block if it has
+// survived at least stuckConfirmations further refreshes without being mined, so the caller can
+// resubmit it with a higher gas price.
+func (t *PendingTxTracker) StuckProveTx(blockID *big.Int) *types.Transaction {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	tracked, ok := t.byBlockID[blockID.Uint64()]
+	if !ok || tracked.misses < stuckConfirmations {
+		return nil
+	}
+
+	return tracked.tx
+}
+
+// countStuckLocked counts the tracked transactions which have reached stuckConfirmations.
+// The caller must hold t.mu.
+func (t *PendingTxTracker) countStuckLocked() int {
+	count := 0
+	for _, tracked := range t.byBlockID {
+		if tracked.misses >= stuckConfirmations {
+			count++
+		}
+	}
+	for _, tracked := range t.bySpender {
+		if tracked.misses >= stuckConfirmations {
+			count++
+		}
+	}
+	return count
+}
+
+// decodeProveBlock decodes the given transaction's calldata as a `proveBlock` call, returning
+// the block ID it targets. The tier isn't decoded here, since it is encoded inside the opaque
+// `input` blob rather than as its own argument; dedup/stuck-tx tracking below keys on block ID
+// alone.
+func decodeProveBlock(tx *types.Transaction) (blockID uint64, ok bool) {
+	data := tx.Data()
+	if len(data) < 4 {
+		return 0, false
+	}
+
+	method, err := proveBlockABI.MethodById(data[:4])
+	if err != nil {
+		return 0, false
+	}
+
+	args := map[string]interface{}{}
+	if err := method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		return 0, false
+	}
+
+	blockID, ok = args["blockId"].(uint64)
+	return
+}
+
+// decodeApprove decodes the given transaction's calldata as an `approve` call on the TaikoToken
+// contract, returning the spender it approves.
+func decodeApprove(tx *types.Transaction) (spender common.Address, ok bool) {
+	data := tx.Data()
+	if len(data) < 4 || !bytes.Equal(data[:4], approveABI.Methods["approve"].ID) {
+		return common.Address{}, false
+	}
+
+	args := map[string]interface{}{}
+	if err := approveABI.Methods["approve"].Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		return common.Address{}, false
+	}
+
+	spender, ok = args["spender"].(common.Address)
+	return
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeApprove(t *testing.T) {
+	spender := common.HexToAddress("0x00000000000000000000000000000000001234")
+
+	data, err := approveABI.Pack("approve", spender, big.NewInt(100))
+	require.NoError(t, err)
+
+	tx := types.NewTx(&types.LegacyTx{Data: data})
+
+	decoded, ok := decodeApprove(tx)
+	require.True(t, ok)
+	require.Equal(t, spender, decoded)
+}
+
+func TestDecodeProveBlock(t *testing.T) {
+	data, err := proveBlockABI.Pack("proveBlock", uint64(123), []byte{0x01})
+	require.NoError(t, err)
+
+	tx := types.NewTx(&types.LegacyTx{Data: data})
+
+	blockID, ok := decodeProveBlock(tx)
+	require.True(t, ok)
+	require.Equal(t, uint64(123), blockID)
+}
+
+func TestPendingTxTracker_IsProvePending(t *testing.T) {
+	data, err := proveBlockABI.Pack("proveBlock", uint64(123), []byte{0x01})
+	require.NoError(t, err)
+
+	tracker := NewPendingTxTracker(nil, common.Address{})
+	trackTx(tracker.byBlockID, uint64(123), types.NewTx(&types.LegacyTx{Data: data}))
+
+	require.True(t, tracker.IsProvePending(big.NewInt(123)))
+	require.False(t, tracker.IsProvePending(big.NewInt(124)))
+}
+
+func TestDecodeApprove_NotApprove(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Data: []byte{0xde, 0xad, 0xbe, 0xef}})
+
+	_, ok := decodeApprove(tx)
+	require.False(t, ok)
+}
@@ -0,0 +1,26 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Content is the decoded response of a `txpool_content` / `txpool_contentFrom` RPC call:
+// pending and queued transactions, keyed by the sender's nonce.
+type Content struct {
+	Pending map[uint64]*types.Transaction `json:"pending"`
+	Queued  map[uint64]*types.Transaction `json:"queued"`
+}
+
+// ContentFrom calls `txpool_contentFrom` on the L1 client, returning the pending and queued
+// transactions for the given account.
+func (c *Client) ContentFrom(ctx context.Context, account common.Address) (*Content, error) {
+	var content Content
+	if err := c.L1.Client.CallContext(ctx, &content, "txpool_contentFrom", account); err != nil {
+		return nil, err
+	}
+
+	return &content, nil
+}
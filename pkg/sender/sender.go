@@ -0,0 +1,124 @@
+package sender
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Confirmation represents the result of a transaction that has been included in a block, or
+// failed to be.
+type Confirmation struct {
+	Receipt *types.Receipt
+	Err     error
+}
+
+// Sender sends and tracks transactions on behalf of a single account, taking care of nonce
+// management and gas pricing.
+type Sender struct {
+	ctx    context.Context
+	client *ethclient.Client
+	opts   *bind.TransactOpts
+
+	// blockBuilderTip, if set, is added on top of the network's suggested priority fee for
+	// every transaction built through GetOpts, so proposals are more likely to be picked up
+	// promptly by MEV-aware block builders.
+	blockBuilderTip *big.Int
+
+	// confirmChMu guards confirmCh, which is written from SendTransaction and waitConfirmation
+	// and read from TxToConfirmChannel, all of which can run concurrently when a single Sender
+	// is shared by several tiers' worker pools.
+	confirmChMu sync.Mutex
+	confirmCh   map[common.Hash]chan *Confirmation
+}
+
+// NewSender creates a new Sender instance.
+func NewSender(
+	ctx context.Context,
+	client *ethclient.Client,
+	opts *bind.TransactOpts,
+	blockBuilderTip *big.Int,
+) *Sender {
+	return &Sender{
+		ctx:             ctx,
+		client:          client,
+		opts:            opts,
+		blockBuilderTip: blockBuilderTip,
+		confirmCh:       make(map[common.Hash]chan *Confirmation),
+	}
+}
+
+// GetOpts returns a copy of the sender's transact opts, with both the priority fee and the fee
+// cap bumped by the configured builder tip, if any, so proposals are more likely to land
+// promptly on MEV-aware block builders. Bumping only GasTipCap would have no effect — or produce
+// an invalid transaction — once it catches up to an unchanged GasFeeCap, since the effective
+// priority fee is capped at `GasFeeCap - baseFee`.
+func (s *Sender) GetOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	opts := *s.opts
+	opts.Context = ctx
+
+	if s.blockBuilderTip == nil || s.blockBuilderTip.Cmp(common.Big0) <= 0 {
+		return &opts, nil
+	}
+
+	if opts.GasTipCap == nil {
+		tipCap, err := s.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts.GasTipCap = tipCap
+	}
+	opts.GasTipCap = new(big.Int).Add(opts.GasTipCap, s.blockBuilderTip)
+
+	if opts.GasFeeCap != nil {
+		opts.GasFeeCap = new(big.Int).Add(opts.GasFeeCap, s.blockBuilderTip)
+	}
+
+	return &opts, nil
+}
+
+// SendTransaction signs and sends the given transaction, returning an ID that can be used to
+// wait for its confirmation through TxToConfirmChannel. The ID is the transaction's hash rather
+// than its nonce, so a gas-bumped resubmission that reuses the nonce gets its own channel
+// instead of silently overwriting (and leaking the waiter goroutine for) the prior submission's.
+func (s *Sender) SendTransaction(tx *types.Transaction) (common.Hash, error) {
+	if err := s.client.SendTransaction(s.ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	id := tx.Hash()
+
+	s.confirmChMu.Lock()
+	s.confirmCh[id] = make(chan *Confirmation, 1)
+	s.confirmChMu.Unlock()
+
+	go s.waitConfirmation(id, tx)
+
+	return id, nil
+}
+
+// TxToConfirmChannel returns the confirmation channel for the transaction with the given ID.
+func (s *Sender) TxToConfirmChannel(id common.Hash) chan *Confirmation {
+	s.confirmChMu.Lock()
+	defer s.confirmChMu.Unlock()
+
+	return s.confirmCh[id]
+}
+
+// waitConfirmation waits for the given transaction to be mined, and forwards the result to its
+// confirmation channel.
+func (s *Sender) waitConfirmation(id common.Hash, tx *types.Transaction) {
+	receipt, err := bind.WaitMined(s.ctx, s.client, tx)
+
+	s.confirmChMu.Lock()
+	ch := s.confirmCh[id]
+	delete(s.confirmCh, id)
+	s.confirmChMu.Unlock()
+
+	ch <- &Confirmation{Receipt: receipt, Err: err}
+}
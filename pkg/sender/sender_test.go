@@ -0,0 +1,41 @@
+package sender
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOpts_BumpsBothTipAndFeeCap(t *testing.T) {
+	s := &Sender{
+		ctx: context.Background(),
+		opts: &bind.TransactOpts{
+			GasTipCap: big.NewInt(2),
+			GasFeeCap: big.NewInt(10),
+		},
+		blockBuilderTip: big.NewInt(3),
+	}
+
+	opts, err := s.GetOpts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), opts.GasTipCap)
+	require.Equal(t, big.NewInt(13), opts.GasFeeCap)
+}
+
+func TestGetOpts_NoTipConfigured(t *testing.T) {
+	s := &Sender{
+		ctx: context.Background(),
+		opts: &bind.TransactOpts{
+			GasTipCap: big.NewInt(2),
+			GasFeeCap: big.NewInt(10),
+		},
+	}
+
+	opts, err := s.GetOpts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2), opts.GasTipCap)
+	require.Equal(t, big.NewInt(10), opts.GasFeeCap)
+}
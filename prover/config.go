@@ -0,0 +1,109 @@
+package prover
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+)
+
+// defaultTierConcurrency is the worker pool size used for a tier that isn't listed in
+// `--prover.tier.concurrency`.
+const defaultTierConcurrency = 1
+
+// Config contains the configuration values the prover needs to start up.
+type Config struct {
+	L1HttpEndpoint          string
+	L1BeaconEndpoint        string
+	L2HttpEndpoint          string
+	RaikoHostEndpoint       string
+	Risc0ProverEndpoint     string
+	Sp1ProverEndpoint       string
+	TaikoL2Address          common.Address
+	Graffiti                string
+	Allowance               *big.Int
+	Dummy                   bool
+	EnableLivenessBondProof bool
+	ContesterMode           bool
+	ProveUnassignedBlocks   bool
+	BackOffRetryInterval    time.Duration
+	BackOffMaxRetrys        uint64
+	BlobAllowed             bool
+	BlockBuilderTip         *big.Int
+	ProofQueueDir           string
+	tierConcurrency         map[uint16]int
+}
+
+// TierConcurrency returns the configured worker pool size for the given tier, falling back to
+// defaultTierConcurrency for any tier not explicitly listed in `--prover.tier.concurrency`.
+func (c *Config) TierConcurrency(tierID uint16) int {
+	if concurrency, ok := c.tierConcurrency[tierID]; ok {
+		return concurrency
+	}
+
+	return defaultTierConcurrency
+}
+
+// parseTierConcurrency parses the `--prover.tier.concurrency` flag value, a comma-separated list
+// of `tierID=concurrency` pairs.
+func parseTierConcurrency(raw string) (map[uint16]int, error) {
+	concurrency := make(map[uint16]int)
+	if raw == "" {
+		return concurrency, nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid --%s entry: %q", flags.ProverTierConcurrency.Name, pair)
+		}
+
+		tierID, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tier ID in --%s entry %q: %w", flags.ProverTierConcurrency.Name, pair, err)
+		}
+
+		workers, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid concurrency in --%s entry %q: %w", flags.ProverTierConcurrency.Name, pair, err)
+		}
+
+		concurrency[uint16(tierID)] = workers
+	}
+
+	return concurrency, nil
+}
+
+// NewConfigFromCliContext creates a new Config instance from the command line flags introduced
+// by the blob, queue, and ZK proof backend changes. Flags that predate those changes are read by
+// the rest of the prover's startup code, not duplicated here.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	tierConcurrency, err := parseTierConcurrency(c.String(flags.ProverTierConcurrency.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var blockBuilderTip *big.Int
+	if raw := c.String(flags.L1BlockBuilderTip.Name); raw != "" {
+		tip, ok := new(big.Int).SetString(raw, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid --%s value: %q", flags.L1BlockBuilderTip.Name, raw)
+		}
+		blockBuilderTip = tip
+	}
+
+	return &Config{
+		Risc0ProverEndpoint: c.String(flags.ProverRisc0Endpoint.Name),
+		Sp1ProverEndpoint:   c.String(flags.ProverSp1Endpoint.Name),
+		BlobAllowed:         c.Bool(flags.L1BlobAllowed.Name),
+		BlockBuilderTip:     blockBuilderTip,
+		ProofQueueDir:       c.String(flags.ProverProofQueueDir.Name),
+		tierConcurrency:     tierConcurrency,
+	}, nil
+}
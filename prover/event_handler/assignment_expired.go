@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
+)
+
+// AssignmentExpiredEventHandler is responsible for handling the events that a block's proof assignment
+// window is expired.
+type AssignmentExpiredEventHandler struct {
+	rpc               *rpc.Client
+	proverAddress     common.Address
+	proofSubmissionCh chan *proofProducer.ProofRequestBody
+	proofContestCh    chan *proofSubmitter.ContestRequestBody
+	contesterMode     bool
+	// isGuardian is true when the current prover is running in guardian mode, in which case an
+	// expired assignment whose existing transition mismatches the local block is resolved by
+	// submitting a guardian-tier proof directly, instead of going through the contest flow.
+	isGuardian bool
+}
+
+// NewAssignmentExpiredEventHandler creates a new AssignmentExpiredEventHandler instance.
+func NewAssignmentExpiredEventHandler(
+	rpc *rpc.Client,
+	proverAddress common.Address,
+	proofSubmissionCh chan *proofProducer.ProofRequestBody,
+	proofContestCh chan *proofSubmitter.ContestRequestBody,
+	contesterMode bool,
+	isGuardian bool,
+) *AssignmentExpiredEventHandler {
+	return &AssignmentExpiredEventHandler{
+		rpc,
+		proverAddress,
+		proofSubmissionCh,
+		proofContestCh,
+		contesterMode,
+		isGuardian,
+	}
+}
+
+// Handle implements the event handling logic for the `AssignmentExpired` event.
+func (h *AssignmentExpiredEventHandler) Handle(ctx context.Context, e *bindings.TaikoL1ClientTransitionProved) error {
+	proofStatus, err := rpc.GetBlockProofStatus(
+		ctx,
+		h.rpc,
+		e.BlockId,
+		e.ParentHash,
+		h.proverAddress,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If the current prover is a guardian prover, it resolves an expired assignment by
+	// submitting a guardian-tier proof directly, but only when the existing transition's
+	// blockhash actually mismatches the local block and no one has contested it yet. If the
+	// transition is already correctly proven, there is nothing for the guardian to do.
+	if h.isGuardian && proofStatus.CurrentTransitionState != nil &&
+		proofStatus.CurrentTransitionState.Contester == rpc.ZeroAddress {
+		matches, err := rpc.IsTransitionMatchingLocalBlock(
+			ctx,
+			h.rpc,
+			e.BlockId,
+			proofStatus.CurrentTransitionState.Tier,
+			proofStatus.CurrentTransitionState.BlockHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if matches {
+			return nil
+		}
+
+		log.Info(
+			"Guardian prover is submitting a guardian-tier proof directly for an expired assignment",
+			"blockID", e.BlockId,
+		)
+
+		h.proofSubmissionCh <- &proofProducer.ProofRequestBody{
+			Tier:  encoding.TierGuardianID,
+			Event: e,
+		}
+
+		return nil
+	}
+
+	if !h.contesterMode {
+		return nil
+	}
+
+	// In contester mode, contest the block's current transition, unless there is no transition
+	// yet or someone has already contested it.
+	if proofStatus.CurrentTransitionState == nil ||
+		proofStatus.CurrentTransitionState.Contester != rpc.ZeroAddress {
+		return nil
+	}
+
+	h.proofContestCh <- &proofSubmitter.ContestRequestBody{
+		BlockID:    e.BlockId,
+		ProposedIn: e.L1BlockId,
+		ParentHash: e.ParentHash,
+		Meta:       e.Meta,
+		Tier:       proofStatus.CurrentTransitionState.Tier,
+	}
+
+	return nil
+}
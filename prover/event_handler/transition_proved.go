@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
+)
+
+// TransitionProvedEventHandler is responsible for handling the `TransitionProved` events.
+type TransitionProvedEventHandler struct {
+	rpc               *rpc.Client
+	proofSubmissionCh chan *proofProducer.ProofRequestBody
+	proofContestCh    chan *proofSubmitter.ContestRequestBody
+	contesterMode     bool
+	// isGuardian is true when the current prover is running in guardian mode, in which case a
+	// transition this prover disagrees with is resolved by submitting a guardian-tier proof
+	// directly, instead of going through the contest flow.
+	isGuardian bool
+}
+
+// NewTransitionProvedEventHandler creates a new TransitionProvedEventHandler instance.
+func NewTransitionProvedEventHandler(
+	rpc *rpc.Client,
+	proofSubmissionCh chan *proofProducer.ProofRequestBody,
+	proofContestCh chan *proofSubmitter.ContestRequestBody,
+	contesterMode bool,
+	isGuardian bool,
+) *TransitionProvedEventHandler {
+	return &TransitionProvedEventHandler{rpc, proofSubmissionCh, proofContestCh, contesterMode, isGuardian}
+}
+
+// Handle implements the event handling logic for the `TransitionProved` event.
+func (h *TransitionProvedEventHandler) Handle(ctx context.Context, e *bindings.TaikoL1ClientTransitionProved) error {
+	// If the proved transition's blockhash matches the local node's view, there is nothing to do.
+	matches, err := rpc.IsTransitionMatchingLocalBlock(ctx, h.rpc, e.BlockId, e.Tier, e.BlockHash)
+	if err != nil {
+		return err
+	}
+	if matches {
+		return nil
+	}
+
+	// A guardian prover settles a disputed transition in one step, by submitting its own
+	// guardian-tier proof directly, as long as the transition is not already guardian-tier and
+	// no one else has contested it yet.
+	if h.isGuardian && e.Tier != encoding.TierGuardianID && e.Contester == rpc.ZeroAddress {
+		log.Info(
+			"Guardian prover disagrees with proved transition, submitting a guardian-tier proof directly",
+			"blockID", e.BlockId,
+			"contestedBlockHash", e.BlockHash,
+		)
+
+		h.proofSubmissionCh <- &proofProducer.ProofRequestBody{
+			Tier:  encoding.TierGuardianID,
+			Event: e,
+		}
+
+		return nil
+	}
+
+	if !h.contesterMode {
+		return nil
+	}
+
+	log.Info("Contesting a proved transition", "blockID", e.BlockId, "contestedBlockHash", e.BlockHash)
+
+	h.proofContestCh <- &proofSubmitter.ContestRequestBody{
+		BlockID:    e.BlockId,
+		ProposedIn: e.L1BlockId,
+		ParentHash: e.ParentHash,
+		Meta:       e.Meta,
+		Tier:       e.Tier,
+	}
+
+	return nil
+}
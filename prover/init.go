@@ -4,17 +4,19 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
-	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-client/pkg/sender"
 	handler "github.com/taikoxyz/taiko-client/prover/event_handler"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
+	"github.com/taikoxyz/taiko-client/prover/proof_submitter/queue"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
 
@@ -51,7 +53,22 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 		return nil
 	}
 
-	opts := p.txSender.GetOpts(ctx)
+	// Skip sending a new approval transaction if one is already pending for this spender, to
+	// avoid stacking up redundant approvals while the first one is still being mined.
+	if p.pendingTxTracker != nil {
+		if err := p.pendingTxTracker.Refresh(ctx); err != nil {
+			return err
+		}
+		if p.pendingTxTracker.IsApprovalPending(contract) {
+			log.Info("Skipping setting allowance, an approval transaction is already pending", "contract", contract)
+			return nil
+		}
+	}
+
+	opts, err := p.txSender.GetOpts(ctx)
+	if err != nil {
+		return err
+	}
 
 	log.Info("Approving the contract for taiko token", "allowance", p.cfg.Allowance.String(), "contract", contract)
 
@@ -98,47 +115,89 @@ func (p *Prover) initProofSubmitters(
 	sender *sender.Sender,
 	txBuilder *transaction.ProveBlockTxBuilder,
 ) error {
+	pendingTxTracker := rpc.NewPendingTxTracker(p.rpc, p.ProverAddress())
+	p.pendingTxTracker = pendingTxTracker
+	p.proofQueues = make(map[uint16]*queue.Queue)
+
 	for _, tier := range p.sharedState.GetTiers() {
 		var (
 			producer  proofProducer.ProofProducer
 			submitter proofSubmitter.Submitter
 			err       error
 		)
-		switch tier.ID {
-		case encoding.TierOptimisticID:
-			producer = &proofProducer.OptimisticProofProducer{}
-		case encoding.TierSgxID:
-			producer = &proofProducer.SGXProofProducer{
-				RaikoHostEndpoint: p.cfg.RaikoHostEndpoint,
-				L1Endpoint:        p.cfg.L1HttpEndpoint,
-				L1BeaconEndpoint:  p.cfg.L1BeaconEndpoint,
-				L2Endpoint:        p.cfg.L2HttpEndpoint,
-				Dummy:             p.cfg.Dummy,
-			}
-		case encoding.TierGuardianID:
-			producer = proofProducer.NewGuardianProofProducer(p.cfg.EnableLivenessBondProof)
-		default:
-			return fmt.Errorf("unsupported tier: %d", tier.ID)
+
+		if producer, err = newProofProducer(tier.ID, p.cfg, p.rpc); err != nil {
+			return err
 		}
 
 		if submitter, err = proofSubmitter.NewProofSubmitter(
 			p.rpc,
 			producer,
-			p.proofGenerationCh,
 			p.cfg.TaikoL2Address,
 			p.cfg.Graffiti,
 			sender,
 			txBuilder,
+			pendingTxTracker,
 		); err != nil {
 			return err
 		}
 
 		p.proofSubmitters = append(p.proofSubmitters, submitter)
+
+		tierQueue, err := queue.New(
+			tier.ID,
+			filepath.Join(p.cfg.ProofQueueDir, fmt.Sprintf("tier-%d", tier.ID)),
+			p.cfg.BackOffRetryInterval,
+			p.cfg.BackOffMaxRetrys,
+			submitter.Submit,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := tierQueue.Start(p.ctx, p.cfg.TierConcurrency(tier.ID)); err != nil {
+			return err
+		}
+
+		p.proofQueues[tier.ID] = tierQueue
 	}
 
+	go p.dispatchProofRequests()
+
 	return nil
 }
 
+// dispatchProofRequests forwards proof requests coming from the `BlockProposed`,
+// `TransitionProved` and `AssignmentExpired` event handlers onto the requested tier's bounded
+// worker pool, replacing the one-shot goroutines the prover used to spawn directly off these
+// channels.
+func (p *Prover) dispatchProofRequests() {
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case req := <-p.proofGenerationCh:
+			p.enqueueProofRequest(req)
+		case req := <-p.proofSubmissionCh:
+			p.enqueueProofRequest(req)
+		}
+	}
+}
+
+// enqueueProofRequest routes a single proof request to its tier's queue, logging and dropping
+// it if the tier has no registered queue.
+func (p *Prover) enqueueProofRequest(req *proofProducer.ProofRequestBody) {
+	tierQueue, ok := p.proofQueues[req.Tier]
+	if !ok {
+		log.Error("Received a proof request for a tier with no queue", "tier", req.Tier, "blockID", req.Event.BlockId)
+		return
+	}
+
+	if err := tierQueue.Enqueue(req); err != nil {
+		log.Error("Failed to enqueue proof request", "tier", req.Tier, "blockID", req.Event.BlockId, "error", err)
+	}
+}
+
 // initL1Current initializes prover's L1Current cursor.
 func (p *Prover) initL1Current(startingBlockID *big.Int) error {
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(p.ctx); err != nil {
@@ -224,8 +283,10 @@ func (p *Prover) initEventHandlers() {
 	// ------- TransitionProved -------
 	p.transitionProvedHandler = handler.NewTransitionProvedEventHandler(
 		p.rpc,
+		p.proofSubmissionCh,
 		p.proofContestCh,
 		p.cfg.ContesterMode,
+		p.IsGuardianProver(),
 	)
 	// ------- TransitionContested -------
 	p.transitionContestedHandler = handler.NewTransitionContestedEventHandler(
@@ -240,6 +301,7 @@ func (p *Prover) initEventHandlers() {
 		p.proofSubmissionCh,
 		p.proofContestCh,
 		p.cfg.ContesterMode,
+		p.IsGuardianProver(),
 	)
 	// ------- BlockVerified -------
 	p.blockVerifiedHandler = new(handler.BlockVerifiedEventHandler)
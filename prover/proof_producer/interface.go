@@ -0,0 +1,32 @@
+package producer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// ProofWithHeader wraps a generated proof together with the block it proves, so the submitter
+// can match it back to the right on-chain `proveBlock` call.
+type ProofWithHeader struct {
+	BlockID *big.Int
+	Meta    *bindings.TaikoDataBlockMetadata
+	Header  *types.Header
+	Tier    uint16
+	Proof   []byte
+}
+
+// ProofProducer is implemented by every supported proof tier backend (Optimistic, SGX, Guardian,
+// and the ZK endpoint producers), and is responsible for turning a proposed block into a proof.
+type ProofProducer interface {
+	RequestProof(
+		ctx context.Context,
+		blockID *big.Int,
+		meta *bindings.TaikoDataBlockMetadata,
+		header *types.Header,
+		tier uint16,
+	) (*ProofWithHeader, error)
+}
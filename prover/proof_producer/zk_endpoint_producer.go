@@ -0,0 +1,126 @@
+package producer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// pollInterval is how often ZkEndpointProofProducer polls its prover endpoint for a proof that
+// is still being generated.
+const pollInterval = 12 * time.Second
+
+// ZkEndpointProofProducer is a ProofProducer that delegates proof generation to an external,
+// configurable prover endpoint, POSTing a proof request and polling for the resulting proof
+// bytes. It is shared by the RISC Zero and SP1 producers, which only differ in the `kind` they
+// send to the endpoint.
+type ZkEndpointProofProducer struct {
+	kind       string
+	endpoint   string
+	dummy      bool
+	httpClient *http.Client
+}
+
+// NewZkEndpointProofProducer creates a new ZkEndpointProofProducer instance.
+func NewZkEndpointProofProducer(kind string, endpoint string, dummy bool) *ZkEndpointProofProducer {
+	return &ZkEndpointProofProducer{kind, endpoint, dummy, &http.Client{Timeout: 10 * time.Second}}
+}
+
+// zkProofRequest is the payload sent to the prover endpoint to request a new proof.
+type zkProofRequest struct {
+	Kind    string `json:"kind"`
+	BlockID uint64 `json:"blockId"`
+}
+
+// zkProofResponse is the prover endpoint's response, either a pending status or the proof bytes.
+type zkProofResponse struct {
+	Status string `json:"status"`
+	Proof  []byte `json:"proof"`
+}
+
+// RequestProof implements the ProofProducer interface, POSTing a proof request to the
+// configured endpoint and polling it until the proof is ready or the context is cancelled.
+func (p *ZkEndpointProofProducer) RequestProof(
+	ctx context.Context,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+	tier uint16,
+) (*ProofWithHeader, error) {
+	if p.dummy {
+		return &ProofWithHeader{
+			BlockID: blockID,
+			Meta:    meta,
+			Header:  header,
+			Tier:    tier,
+			Proof:   bytes.Repeat([]byte{0xff}, 32),
+		}, nil
+	}
+
+	body, err := json.Marshal(&zkProofRequest{Kind: p.kind, BlockID: blockID.Uint64()})
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := p.pollOnce(ctx, body)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status == "complete" {
+			return &ProofWithHeader{
+				BlockID: blockID,
+				Meta:    meta,
+				Header:  header,
+				Tier:    tier,
+				Proof:   resp.Proof,
+			}, nil
+		}
+
+		log.Info("Waiting for proof", "kind", p.kind, "blockID", blockID, "status", resp.Status)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollOnce sends a single proof request to the endpoint and decodes the response.
+func (p *ZkEndpointProofProducer) pollOnce(ctx context.Context, body []byte) (*zkProofResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s prover endpoint returned status %d", p.kind, httpResp.StatusCode)
+	}
+
+	var resp zkProofResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
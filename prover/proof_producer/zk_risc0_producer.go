@@ -0,0 +1,12 @@
+package producer
+
+// ZkRisc0ProofProducer generates proofs by POSTing a proof request to a configurable RISC Zero
+// prover endpoint and polling it until the proof bytes are ready.
+type ZkRisc0ProofProducer struct {
+	*ZkEndpointProofProducer
+}
+
+// NewZkRisc0ProofProducer creates a new ZkRisc0ProofProducer instance.
+func NewZkRisc0ProofProducer(endpoint string, dummy bool) *ZkRisc0ProofProducer {
+	return &ZkRisc0ProofProducer{NewZkEndpointProofProducer("risc0", endpoint, dummy)}
+}
@@ -0,0 +1,12 @@
+package producer
+
+// ZkSp1ProofProducer generates proofs by POSTing a proof request to a configurable SP1 prover
+// endpoint and polling it until the proof bytes are ready.
+type ZkSp1ProofProducer struct {
+	*ZkEndpointProofProducer
+}
+
+// NewZkSp1ProofProducer creates a new ZkSp1ProofProducer instance.
+func NewZkSp1ProofProducer(endpoint string, dummy bool) *ZkSp1ProofProducer {
+	return &ZkSp1ProofProducer{NewZkEndpointProofProducer("sp1", endpoint, dummy)}
+}
@@ -0,0 +1,241 @@
+// Package queue implements a per-tier, bounded, disk-persisted queue of proof requests, used to
+// replace the one-shot goroutines that used to be spawned directly off proofGenerationCh.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var (
+	queueDepthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "taiko_prover_proof_queue_depth",
+		Help: "Number of proof requests waiting in a tier's queue",
+	}, []string{"tier"})
+	inFlightGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "taiko_prover_proof_in_flight",
+		Help: "Number of proof requests currently being worked on by a tier's worker pool",
+	}, []string{"tier"})
+	retryCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "taiko_prover_proof_retries_total",
+		Help: "Number of times a proof request has been retried after a producer error",
+	}, []string{"tier"})
+	resultCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "taiko_prover_proof_results_total",
+		Help: "Number of proof requests that finished, by tier and outcome",
+	}, []string{"tier", "outcome"})
+)
+
+// Handler processes a single proof request. It is called by the worker pool, with retries
+// driven by the queue when it returns an error.
+type Handler func(ctx context.Context, req *proofProducer.ProofRequestBody) error
+
+// Queue is a bounded, disk-persisted, per-tier queue of proof requests, drained by a
+// configurable number of concurrent workers, with exponential backoff between retries of a
+// failed request.
+type Queue struct {
+	tier             uint16
+	tierName         string
+	db               *leveldb.DB
+	handler          Handler
+	items            chan *proofProducer.ProofRequestBody
+	backOffRetryIval time.Duration
+	backOffMaxRetrys uint64
+}
+
+// New creates a new Queue for a single proof tier, backed by a LevelDB database at the given
+// path. Requests still persisted from a previous run are restored by Start, not here.
+func New(
+	tier uint16,
+	dbPath string,
+	backOffRetryIval time.Duration,
+	backOffMaxRetrys uint64,
+	handler Handler,
+) (*Queue, error) {
+	db, err := leveldb.OpenFile(dbPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		tier:             tier,
+		tierName:         fmt.Sprintf("%d", tier),
+		db:               db,
+		handler:          handler,
+		items:            make(chan *proofProducer.ProofRequestBody, 1024),
+		backOffRetryIval: backOffRetryIval,
+		backOffMaxRetrys: backOffMaxRetrys,
+	}
+
+	return q, nil
+}
+
+// Start launches the queue's worker pool, and requeues any persisted requests left over from a
+// previous run. Workers are started before the restore runs, so a backlog larger than the
+// in-memory channel buffer still drains instead of deadlocking on startup.
+func (q *Queue) Start(ctx context.Context, concurrency int) error {
+	for i := 0; i < concurrency; i++ {
+		go q.worker(ctx)
+	}
+
+	return q.restore(ctx)
+}
+
+// Enqueue persists the given proof request and adds it to the queue, to be picked up by the
+// next available worker. It never blocks: if the tier's in-memory buffer is full, the request is
+// already durably persisted and will be picked up by restore on the next restart, so Enqueue
+// logs and moves on instead of blocking the caller (typically a single dispatcher goroutine
+// shared by every tier) on this one tier's backlog.
+func (q *Queue) Enqueue(req *proofProducer.ProofRequestBody) error {
+	key, value, err := encode(req)
+	if err != nil {
+		return err
+	}
+
+	if err := q.db.Put(key, value, nil); err != nil {
+		return err
+	}
+
+	select {
+	case q.items <- req:
+		queueDepthGauge.WithLabelValues(q.tierName).Inc()
+	default:
+		log.Warn(
+			"Tier's proof queue buffer is full, request stays persisted and will be picked up on next restart",
+			"tier", q.tier,
+			"blockID", req.Event.BlockId,
+		)
+	}
+
+	return nil
+}
+
+// restore re-enqueues every proof request that was persisted but never completed, so in-flight
+// assignments are not lost across a prover restart. It is called after the worker pool has
+// already been started, so a backlog larger than the items channel's buffer still drains
+// instead of blocking forever.
+func (q *Queue) restore(ctx context.Context) error {
+	iter := q.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var req proofProducer.ProofRequestBody
+		if err := json.Unmarshal(iter.Value(), &req); err != nil {
+			log.Warn("Failed to restore a persisted proof request, skipping", "error", err)
+			continue
+		}
+
+		select {
+		case q.items <- &req:
+			queueDepthGauge.WithLabelValues(q.tierName).Inc()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return iter.Error()
+}
+
+// worker pulls proof requests off the queue and processes them one at a time, retrying on
+// failure with exponential backoff up to backOffMaxRetrys.
+func (q *Queue) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-q.items:
+			queueDepthGauge.WithLabelValues(q.tierName).Dec()
+			inFlightGauge.WithLabelValues(q.tierName).Inc()
+			q.process(ctx, req)
+			inFlightGauge.WithLabelValues(q.tierName).Dec()
+		}
+	}
+}
+
+// process runs the queue's handler for the given request, retrying with exponential backoff on
+// error, and removes the request from disk once it either succeeds or exhausts its retries. If
+// ctx is cancelled mid-retry, the persisted entry is deliberately left on disk, so it is restored
+// and retried on the next restart instead of being lost.
+func (q *Queue) process(ctx context.Context, req *proofProducer.ProofRequestBody) {
+	removeFromDisk := func() {
+		key, _, _ := encode(req)
+		if err := q.db.Delete(key, nil); err != nil {
+			log.Warn("Failed to remove a completed proof request from the queue", "error", err)
+		}
+	}
+
+	var err error
+	for attempt := uint64(0); attempt <= q.backOffMaxRetrys; attempt++ {
+		if err = q.handler(ctx, req); err == nil {
+			resultCounter.WithLabelValues(q.tierName, "success").Inc()
+			removeFromDisk()
+			return
+		}
+
+		if attempt == q.backOffMaxRetrys {
+			break
+		}
+
+		log.Warn(
+			"Proof request failed, will retry",
+			"blockID", req.Event.BlockId,
+			"tier", q.tier,
+			"attempt", attempt,
+			"error", err,
+		)
+		retryCounter.WithLabelValues(q.tierName).Inc()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backOff(q.backOffRetryIval, attempt)):
+		}
+	}
+
+	resultCounter.WithLabelValues(q.tierName, "failure").Inc()
+	log.Error("Proof request exhausted its retries", "blockID", req.Event.BlockId, "tier", q.tier, "error", err)
+	removeFromDisk()
+}
+
+// maxBackOffShift caps the shift used by backOff, since attempt grows without bound across
+// retries and 1<<attempt would otherwise wrap back around to 0 once attempt reaches 64,
+// producing a zero-delay retry spin.
+const maxBackOffShift = 32
+
+// backOff returns an exponentially increasing delay, doubling the base interval for every
+// attempt, up to maxBackOffShift doublings.
+func backOff(base time.Duration, attempt uint64) time.Duration {
+	if attempt > maxBackOffShift {
+		attempt = maxBackOffShift
+	}
+
+	return base * time.Duration(new(big.Int).Lsh(big.NewInt(1), uint(attempt)).Uint64())
+}
+
+// encode returns the LevelDB key/value pair used to persist the given proof request, keyed by
+// block ID and tier, so a repeated request for the same block and tier overwrites the earlier one.
+func encode(req *proofProducer.ProofRequestBody) ([]byte, []byte, error) {
+	value, err := json.Marshal(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := []byte(fmt.Sprintf("%d-%d", req.Event.BlockId, req.Tier))
+
+	return key, value, nil
+}
+
+// Close closes the queue's underlying database.
+func (q *Queue) Close() error {
+	return q.db.Close()
+}
@@ -0,0 +1,99 @@
+package queue
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestBackOff(t *testing.T) {
+	base := time.Second
+
+	require.Equal(t, time.Second, backOff(base, 0))
+	require.Equal(t, 2*time.Second, backOff(base, 1))
+	require.Equal(t, 4*time.Second, backOff(base, 2))
+}
+
+// TestBackOff_ClampsHighAttempts ensures a large attempt count doesn't wrap 1<<attempt back
+// around to 0 and produce a zero-delay retry spin.
+func TestBackOff_ClampsHighAttempts(t *testing.T) {
+	base := time.Second
+
+	require.Equal(t, backOff(base, maxBackOffShift), backOff(base, 64))
+	require.Equal(t, backOff(base, maxBackOffShift), backOff(base, 1000))
+	require.NotZero(t, backOff(base, 64))
+}
+
+// TestProcess_CtxCancelledLeavesPersisted ensures a request that's still retrying when ctx is
+// cancelled stays persisted on disk, so it is restored and retried after a restart instead of
+// being lost.
+func TestProcess_CtxCancelledLeavesPersisted(t *testing.T) {
+	dbPath := t.TempDir()
+
+	q, err := New(100, dbPath, time.Hour, 10, func(context.Context, *proofProducer.ProofRequestBody) error {
+		return require.AnError
+	})
+	require.NoError(t, err)
+	defer q.Close()
+
+	req := &proofProducer.ProofRequestBody{
+		Tier:  100,
+		Event: &bindings.TaikoL1ClientTransitionProved{BlockId: 1},
+	}
+	key, value, err := encode(req)
+	require.NoError(t, err)
+	require.NoError(t, q.db.Put(key, value, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	q.process(ctx, req)
+
+	_, err = q.db.Get(key, nil)
+	require.NoError(t, err, "persisted request should not be removed when ctx is cancelled mid-retry")
+}
+
+// TestStart_RestoreLargerThanChannelBuffer ensures Start does not deadlock when the persisted
+// backlog is larger than the in-memory items channel buffer, since workers must already be
+// draining it while restore fills it back up.
+func TestStart_RestoreLargerThanChannelBuffer(t *testing.T) {
+	const backlog = 1500 // larger than the queue's 1024-item channel buffer.
+
+	var processed int64
+	dbPath := t.TempDir()
+
+	// Seed the on-disk queue directly, as if it was left over by a prover that was killed with
+	// a large backlog still pending, without going through Enqueue's in-memory channel.
+	seed, err := New(100, dbPath, time.Millisecond, 0, nil)
+	require.NoError(t, err)
+	for i := 0; i < backlog; i++ {
+		key, value, err := encode(&proofProducer.ProofRequestBody{
+			Tier:  100,
+			Event: &bindings.TaikoL1ClientTransitionProved{BlockId: uint64(i)},
+		})
+		require.NoError(t, err)
+		require.NoError(t, seed.db.Put(key, value, nil))
+	}
+	require.NoError(t, seed.Close())
+
+	q, err := New(100, dbPath, time.Millisecond, 0, func(context.Context, *proofProducer.ProofRequestBody) error {
+		atomic.AddInt64(&processed, 1)
+		return nil
+	})
+	require.NoError(t, err)
+	defer q.Close()
+
+	go func() {
+		require.NoError(t, q.Start(context.Background(), 4))
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&processed) >= backlog
+	}, 10*time.Second, 10*time.Millisecond)
+}
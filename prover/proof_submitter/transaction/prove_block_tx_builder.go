@@ -0,0 +1,100 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// blobBytesThreshold is the minimum size of the encoded proveBlock input, in bytes, at or above
+// which the tx builder will prefer encoding it as an EIP-4844 blob sidecar over calldata,
+// when blob transactions are allowed.
+const blobBytesThreshold = 100_000
+
+// ProveBlockTxBuilder is responsible for building the proveBlock transactions,
+// choosing between calldata and blob-sidecar encoding for the proposal payload.
+type ProveBlockTxBuilder struct {
+	rpc            *rpc.Client
+	taikoL1Address common.Address
+	blobAllowed    bool
+}
+
+// NewProveBlockTxBuilder creates a new ProveBlockTxBuilder instance.
+func NewProveBlockTxBuilder(
+	rpc *rpc.Client,
+	taikoL1Address common.Address,
+	blobAllowed bool,
+) *ProveBlockTxBuilder {
+	return &ProveBlockTxBuilder{rpc, taikoL1Address, blobAllowed}
+}
+
+// Build creates a new TaikoL1.proveBlock transaction, encoding the given proof as a blob
+// sidecar when blob transactions are allowed and the payload is large enough to benefit from it,
+// otherwise falling back to calldata.
+func (b *ProveBlockTxBuilder) Build(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	transition *bindings.TaikoDataTransitionState,
+	tierProof *bindings.TaikoDataTierProof,
+) (*types.Transaction, error) {
+	input, err := encoding.EncodeProveBlockInput(meta, transition, tierProof)
+	if err != nil {
+		return nil, err
+	}
+
+	if !b.useBlob(input) {
+		return b.rpc.TaikoL1.ProveBlock(opts, blockID, input)
+	}
+
+	sidecar, err := encoding.MakeBlobSidecar(input)
+	if err != nil {
+		return nil, err
+	}
+
+	blobFeeCap, err := b.blobFeeCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts.BlobFeeCap = blobFeeCap
+	opts.BlobHashes = sidecar.BlobHashes()
+	// Carry the actual blob contents (blobs/commitments/proofs) through to the transaction,
+	// not just their versioned hashes — a blob tx cannot be gossiped or included without them.
+	opts.BlobSidecar = sidecar
+
+	return b.rpc.TaikoL1.ProveBlock(opts, blockID, []byte{})
+}
+
+// useBlob decides whether the given payload should be submitted as a blob sidecar.
+func (b *ProveBlockTxBuilder) useBlob(input []byte) bool {
+	return b.blobAllowed && len(input) >= blobBytesThreshold
+}
+
+// blobFeeCap computes a blob base fee cap from the current L1 `excess_blob_gas`, with some
+// headroom so the transaction does not get stuck if blob demand rises before inclusion.
+func (b *ProveBlockTxBuilder) blobFeeCap(ctx context.Context) (*big.Int, error) {
+	header, err := b.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	excessBlobGas := header.ExcessBlobGas
+	if excessBlobGas == nil {
+		return big.NewInt(params.BlobTxMinBlobGasprice), nil
+	}
+
+	blobBaseFee := eip4844.CalcBlobFee(*excessBlobGas)
+
+	// Add 12.5% headroom, matching the per-block max blob base fee increase.
+	return new(big.Int).Add(blobBaseFee, new(big.Int).Div(blobBaseFee, big.NewInt(8))), nil
+}
@@ -0,0 +1,79 @@
+//go:build integration
+
+// Package transaction end-to-end tests run against a live L1 devnet (Anvil or a Kurtosis-style
+// Taiko devnet), exercising both the calldata and blob submission paths. They are gated behind
+// the `integration` build tag and an `L1_HTTP_ENDPOINT` env var, since they need a real chain to
+// submit transactions to and wait for inclusion.
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func newTestClient(t *testing.T) *rpc.Client {
+	endpoint := os.Getenv("L1_HTTP_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("L1_HTTP_ENDPOINT not set, skipping end-to-end blob submission test")
+	}
+
+	client, err := rpc.NewClient(context.Background(), &rpc.ClientConfig{L1Endpoint: endpoint})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestProveBlockTxBuilder_E2E_Calldata(t *testing.T) {
+	client := newTestClient(t)
+	opts := testutils.DefaultTestOpts(t, client)
+
+	b := NewProveBlockTxBuilder(client, testutils.TaikoL1Address, false)
+
+	tx, err := b.Build(
+		context.Background(),
+		opts,
+		big.NewInt(1),
+		&bindings.TaikoDataBlockMetadata{},
+		&bindings.TaikoDataTransitionState{},
+		&bindings.TaikoDataTierProof{},
+	)
+	require.NoError(t, err)
+	require.Nil(t, tx.BlobHashes())
+
+	receipt, err := bind.WaitMined(context.Background(), client.L1, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receipt.Status)
+}
+
+func TestProveBlockTxBuilder_E2E_Blob(t *testing.T) {
+	client := newTestClient(t)
+	opts := testutils.DefaultTestOpts(t, client)
+
+	b := NewProveBlockTxBuilder(client, testutils.TaikoL1Address, true)
+
+	largeTierProof := &bindings.TaikoDataTierProof{Data: make([]byte, blobBytesThreshold+1)}
+
+	tx, err := b.Build(
+		context.Background(),
+		opts,
+		big.NewInt(2),
+		&bindings.TaikoDataBlockMetadata{},
+		&bindings.TaikoDataTransitionState{},
+		largeTierProof,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, tx.BlobHashes())
+
+	receipt, err := bind.WaitMined(context.Background(), client.L1, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receipt.Status)
+}
@@ -0,0 +1,16 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProveBlockTxBuilder_UseBlob(t *testing.T) {
+	b := &ProveBlockTxBuilder{blobAllowed: false}
+	require.False(t, b.useBlob(make([]byte, blobBytesThreshold+1)))
+
+	b.blobAllowed = true
+	require.False(t, b.useBlob(make([]byte, blobBytesThreshold-1)))
+	require.True(t, b.useBlob(make([]byte, blobBytesThreshold+1)))
+}
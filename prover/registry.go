@@ -0,0 +1,61 @@
+package prover
+
+import (
+	"fmt"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// ProofProducerFactory builds a ProofProducer for a specific proof tier, from the prover's
+// config and RPC client. Third parties can register factories for new tiers at init time,
+// without patching initProofSubmitters.
+type ProofProducerFactory func(cfg *Config, rpc *rpc.Client) (proofProducer.ProofProducer, error)
+
+// proofProducerRegistry holds the factories for every proof tier this prover build knows how to
+// generate proofs for.
+var proofProducerRegistry = map[uint16]ProofProducerFactory{}
+
+// RegisterProofProducer registers a ProofProducerFactory for the given tier ID, overwriting any
+// previously registered factory for that tier. It is meant to be called from an `init` function,
+// so that custom builds can add support for new proof backends without touching this package.
+func RegisterProofProducer(tierID uint16, factory ProofProducerFactory) {
+	proofProducerRegistry[tierID] = factory
+}
+
+// newProofProducer looks up the registered factory for the given tier ID and uses it to build a
+// ProofProducer.
+func newProofProducer(tierID uint16, cfg *Config, rpc *rpc.Client) (proofProducer.ProofProducer, error) {
+	factory, ok := proofProducerRegistry[tierID]
+	if !ok {
+		return nil, fmt.Errorf("unsupported tier: %d", tierID)
+	}
+
+	return factory(cfg, rpc)
+}
+
+// init registers the prover's built-in proof tiers.
+func init() {
+	RegisterProofProducer(encoding.TierOptimisticID, func(*Config, *rpc.Client) (proofProducer.ProofProducer, error) {
+		return &proofProducer.OptimisticProofProducer{}, nil
+	})
+	RegisterProofProducer(encoding.TierSgxID, func(cfg *Config, _ *rpc.Client) (proofProducer.ProofProducer, error) {
+		return &proofProducer.SGXProofProducer{
+			RaikoHostEndpoint: cfg.RaikoHostEndpoint,
+			L1Endpoint:        cfg.L1HttpEndpoint,
+			L1BeaconEndpoint:  cfg.L1BeaconEndpoint,
+			L2Endpoint:        cfg.L2HttpEndpoint,
+			Dummy:             cfg.Dummy,
+		}, nil
+	})
+	RegisterProofProducer(encoding.TierGuardianID, func(cfg *Config, _ *rpc.Client) (proofProducer.ProofProducer, error) {
+		return proofProducer.NewGuardianProofProducer(cfg.EnableLivenessBondProof), nil
+	})
+	RegisterProofProducer(encoding.TierZkRisc0ID, func(cfg *Config, _ *rpc.Client) (proofProducer.ProofProducer, error) {
+		return proofProducer.NewZkRisc0ProofProducer(cfg.Risc0ProverEndpoint, cfg.Dummy), nil
+	})
+	RegisterProofProducer(encoding.TierZkSp1ID, func(cfg *Config, _ *rpc.Client) (proofProducer.ProofProducer, error) {
+		return proofProducer.NewZkSp1ProofProducer(cfg.Sp1ProverEndpoint, cfg.Dummy), nil
+	})
+}
@@ -0,0 +1,27 @@
+package prover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestRegisterProofProducer(t *testing.T) {
+	const customTierID = uint16(9999)
+
+	RegisterProofProducer(customTierID, func(*Config, *rpc.Client) (proofProducer.ProofProducer, error) {
+		return &proofProducer.OptimisticProofProducer{}, nil
+	})
+
+	producer, err := newProofProducer(customTierID, &Config{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &proofProducer.OptimisticProofProducer{}, producer)
+}
+
+func TestNewProofProducer_UnsupportedTier(t *testing.T) {
+	_, err := newProofProducer(uint16(0xffff), &Config{}, nil)
+	require.ErrorContains(t, err, "unsupported tier")
+}